@@ -0,0 +1,73 @@
+package easyminio
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// DownloadFileContext downloads the file at path to the specified
+// local path, aborting if ctx is cancelled before it completes.
+func (s *S3Service) DownloadFileContext(ctx context.Context, path, localPath string) error {
+	return s.s3Client.FGetObject(ctx, s.bucketName, path, localPath, minio.GetObjectOptions{})
+}
+
+// DownloadFile downloads the file at path to the specified local path.
+func (s *S3Service) DownloadFile(path, localPath string) error {
+	return s.DownloadFileContext(context.Background(), path, localPath)
+}
+
+// UploadFileContext uploads the contents of r to path as UploadFile
+// does, aborting if ctx is cancelled before it completes.
+func (s *S3Service) UploadFileContext(ctx context.Context, path string, r io.Reader, opts UploadOptions) (ObjectInfo, error) {
+	putOpts := opts.putObjectOptions()
+
+	if putOpts.ContentType == "" {
+		sniffed, peeked, err := detectContentType(r)
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		putOpts.ContentType = sniffed
+		r = peeked
+	}
+
+	if opts.Progress != nil {
+		r = io.TeeReader(r, opts.Progress)
+	}
+
+	if _, err := s.s3Client.PutObject(ctx, s.bucketName, path, r, -1, putOpts); err != nil {
+		return ObjectInfo{}, err
+	}
+
+	info, err := s.s3Client.StatObject(ctx, s.bucketName, path, minio.StatObjectOptions{})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	return objectInfoFromMinio(info), nil
+}
+
+// GetFileURLContext generates a link to the file at the given path
+// that expires after the specified duration, failing early if ctx is
+// already done.
+func (s *S3Service) GetFileURLContext(ctx context.Context, path string, expiration time.Duration, opts PresignGetOptions) (*url.URL, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.s3Client.PresignedGetObject(ctx, s.bucketName, path, expiration, opts.queryValues())
+}
+
+// listObjectsContext is like s.s3Client.ListObjects, but scoped to a
+// small helper so call sites don't have to build ListObjectsOptions
+// themselves; it stops as soon as ctx is cancelled since minio-go v7
+// listings are natively ctx-aware.
+func (s *S3Service) listObjectsContext(ctx context.Context, prefix string, recursive bool) <-chan minio.ObjectInfo {
+	return s.s3Client.ListObjects(ctx, s.bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: recursive,
+	})
+}
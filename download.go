@@ -0,0 +1,89 @@
+package easyminio
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultDownloadPoolSize bounds the number of concurrent file
+// downloads DownloadDirectory will perform when opts.Concurrency is
+// left at zero.
+var defaultDownloadPoolSize = runtime.NumCPU()
+
+// DownloadOptions configures DownloadDirectory and
+// DownloadDirectoryContext.
+type DownloadOptions struct {
+	// Concurrency bounds the number of files downloaded at once.
+	// Zero means runtime.NumCPU().
+	Concurrency uint
+}
+
+func (o DownloadOptions) concurrency() int {
+	if o.Concurrency > 0 {
+		return int(o.Concurrency)
+	}
+	return defaultDownloadPoolSize
+}
+
+// DownloadDirectoryContext concurrently downloads the remote s3
+// directory path to the local file system at the specified location
+// through a fixed-size worker pool (opts.Concurrency, default
+// runtime.NumCPU()). The first download to fail cancels ctx, which
+// stops in-flight siblings and any further downloads from starting -
+// it never enqueues the whole listing up front.
+func (s *S3Service) DownloadDirectoryContext(ctx context.Context, path, localPath string, opts ...DownloadOptions) error {
+	var o DownloadOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, o.concurrency())
+
+	objectCh := s.listObjectsContext(ctx, path, true)
+
+loop:
+	for obj := range objectCh {
+		if obj.Err != nil {
+			g.Go(func() error { return obj.Err })
+			break
+		}
+
+		if strings.HasSuffix(obj.Key, "/") { // don't try to download directory as a file
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+
+		obj := obj
+		g.Go(func() error {
+			defer func() { <-sem }()
+
+			fileName := strings.TrimPrefix(obj.Key, path+"/")
+			dest := filepath.Join(localPath, fileName)
+
+			if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+				return err
+			}
+
+			return s.DownloadFileContext(ctx, obj.Key, dest)
+		})
+	}
+
+	return g.Wait()
+}
+
+// DownloadDirectory concurrently downloads the remote s3 directory
+// path to the local file system at the specified location.
+func (s *S3Service) DownloadDirectory(path, localPath string, opts ...DownloadOptions) error {
+	return s.DownloadDirectoryContext(context.Background(), path, localPath, opts...)
+}
@@ -2,33 +2,66 @@
 package easyminio
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/url"
-	netUrl "net/url"
 	"strings"
-	"sync"
 	"time"
 
-	"github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
 )
 
+// minioAPI is the subset of *minio.Client this package depends on. It
+// exists so tests can substitute an in-memory fake for the handful of
+// calls fs.go needs, instead of requiring a real S3-compatible server.
+type minioAPI interface {
+	StatObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+	GetObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error)
+	ListObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
+	RemoveObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+	RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError
+	CopyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	EnableVersioning(ctx context.Context, bucketName string) error
+	FGetObject(ctx context.Context, bucketName, objectName, filePath string, opts minio.GetObjectOptions) error
+	PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	PresignedGetObject(ctx context.Context, bucketName, objectName string, expires time.Duration, reqParams url.Values) (*url.URL, error)
+	PresignedPutObject(ctx context.Context, bucketName, objectName string, expires time.Duration) (*url.URL, error)
+	GetBucketLifecycle(ctx context.Context, bucketName string) (*lifecycle.Configuration, error)
+	SetBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+}
+
+// minioClientAdapter narrows *minio.Client's GetObject - which returns
+// the concrete *minio.Object - down to minioAPI's io.ReadCloser, which
+// is all fs.go actually uses and all an in-memory fake can produce.
+type minioClientAdapter struct {
+	*minio.Client
+}
+
+func (a minioClientAdapter) GetObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+	return a.Client.GetObject(ctx, bucketName, objectName, opts)
+}
+
 // S3Service service to easily interface with s3
 type S3Service struct {
-	s3Client       *minio.Client
+	s3Client       minioAPI
 	lifeCycleRules string
 	bucketName     string
-	urlValues      url.Values
 }
 
 // NewS3Service creates a new instace of the s3 service using the provided details
 func NewS3Service(url, accessKey, accessSecret, bucketName string) (*S3Service, error) {
-	s3Client, err := minio.New(url, accessKey, accessSecret, true)
+	s3Client, err := minio.New(url, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, accessSecret, ""),
+		Secure: true,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	exists, err := s3Client.BucketExists(bucketName)
+	exists, err := s3Client.BucketExists(context.Background(), bucketName)
 	if err != nil {
 		return nil, err
 	}
@@ -37,14 +70,10 @@ func NewS3Service(url, accessKey, accessSecret, bucketName string) (*S3Service,
 		return nil, fmt.Errorf("s3 bucket (%s) doesn't exist", bucketName)
 	}
 
-	urlValues := make(netUrl.Values)
-	urlValues.Set("response-content-disposition", "inline")
-
 	return &S3Service{
-		s3Client:       s3Client,
+		s3Client:       minioClientAdapter{Client: s3Client},
 		lifeCycleRules: "",
 		bucketName:     bucketName,
-		urlValues:      urlValues,
 	}, nil
 }
 
@@ -55,89 +84,39 @@ func (s *S3Service) AddLifeCycleRule(ruleID, folderPath string, daysToExpiry int
 		folderPath += "/"
 	}
 
-	lifeCycleString := fmt.Sprintf(
-		`<LifecycleConfiguration><Rule><ID>%s</ID><Prefix>%s</Prefix><Status>Enabled</Status><Expiration><Days>%d</Days></Expiration></Rule></LifecycleConfiguration>`,
-		ruleID, folderPath, daysToExpiry)
+	cfg := &lifecycle.Configuration{
+		Rules: []lifecycle.Rule{
+			{
+				ID:         ruleID,
+				Prefix:     folderPath,
+				Status:     "Enabled",
+				Expiration: lifecycle.Expiration{Days: lifecycle.ExpirationDays(daysToExpiry)},
+			},
+		},
+	}
 
-	return s.s3Client.SetBucketLifecycle(s.bucketName, lifeCycleString)
+	return s.s3Client.SetBucketLifecycle(context.Background(), s.bucketName, cfg)
 }
 
 // UploadJSONFile uploads a json file from the reader to the specified path
 func (s *S3Service) UploadJSONFile(path string, data io.Reader) error {
-	_, err := s.s3Client.PutObject(s.bucketName, path, data, -1, minio.PutObjectOptions{ContentType: "application/json"})
+	_, err := s.s3Client.PutObject(context.Background(), s.bucketName, path, data, -1, minio.PutObjectOptions{ContentType: "application/json"})
 	return err
 }
 
 // GetFileURL generates a link to the file at the given path
 // that expires after the specified duration
-func (s *S3Service) GetFileURL(path string, expiration time.Duration) (*url.URL, error) {
-	return s.s3Client.PresignedGetObject(s.bucketName, path, expiration, s.urlValues)
+func (s *S3Service) GetFileURL(path string, expiration time.Duration, opts PresignGetOptions) (*url.URL, error) {
+	return s.GetFileURLContext(context.Background(), path, expiration, opts)
 }
 
 // UploadJSONFileWithLink uploads a json file and returns a public link to the file
 // that expires after the specified duration
 func (s *S3Service) UploadJSONFileWithLink(path string, data io.Reader, expiration time.Duration) (*url.URL, error) {
-	_, err := s.s3Client.PutObject(s.bucketName, path, data, -1, minio.PutObjectOptions{ContentType: "application/json"})
+	_, err := s.s3Client.PutObject(context.Background(), s.bucketName, path, data, -1, minio.PutObjectOptions{ContentType: "application/json"})
 	if err != nil {
 		return nil, err
 	}
 
-	return s.s3Client.PresignedGetObject(s.bucketName, path, 24*time.Hour, s.urlValues)
-}
-
-// DownloadDirectory concurrently downloads the remote s3 directory path
-// to the local file system at the specified location
-func (s *S3Service) DownloadDirectory(path, localPath string) error {
-	doneCh := make(chan struct{})
-	defer close(doneCh)
-
-	objectCh := s.s3Client.ListObjectsV2(s.bucketName, path, true, doneCh)
-
-	wg := sync.WaitGroup{}
-	errCh := make(chan error)
-
-	for obj := range objectCh {
-		if obj.Err != nil {
-			return obj.Err
-		}
-
-		wg.Add(1)
-
-		go func(obj minio.ObjectInfo) {
-			if strings.HasSuffix(obj.Key, "/") { // don't try to download directory as a file
-				wg.Done()
-				return
-			}
-
-			fileName := strings.TrimPrefix(obj.Key, path+"/")
-
-			err := s.DownloadFile(obj.Key, localPath+"/"+fileName)
-			if err != nil {
-				errCh <- err
-			}
-
-			wg.Done()
-		}(obj)
-	}
-
-	go func() {
-		wg.Wait()
-		close(errCh)
-	}()
-
-	errs := []error{}
-	for err := range errCh {
-		errs = append(errs, err)
-	}
-
-	if len(errs) > 0 {
-		return fmt.Errorf("failed to download files from s3: %v", errs)
-	}
-
-	return nil
-}
-
-// DownloadFile downloads the file at path to the specified local path
-func (s *S3Service) DownloadFile(path, localPath string) error {
-	return s.s3Client.FGetObject(s.bucketName, path, localPath, minio.GetObjectOptions{})
+	return s.GetFileURL(path, expiration, PresignGetOptions{})
 }
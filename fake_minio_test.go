@@ -0,0 +1,130 @@
+package easyminio
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+// fakeMinioClient is an in-memory minioAPI used by tests so fs.FS
+// behaviour can be exercised without a real S3-compatible server. Only
+// the read path (StatObject, GetObject, ListObjects) is implemented;
+// RemoveObjects returns an already-closed channel (no per-key errors)
+// and every other method returns an error.
+type fakeMinioClient struct {
+	objects map[string][]byte
+}
+
+func newFakeS3Service(objects map[string][]byte) *S3Service {
+	return &S3Service{
+		s3Client:   &fakeMinioClient{objects: objects},
+		bucketName: "test-bucket",
+	}
+}
+
+func (f *fakeMinioClient) StatObject(_ context.Context, _, objectName string, _ minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	data, ok := f.objects[objectName]
+	if !ok {
+		return minio.ObjectInfo{}, errors.New("fakeMinioClient: no such key: " + objectName)
+	}
+
+	return minio.ObjectInfo{Key: objectName, Size: int64(len(data))}, nil
+}
+
+func (f *fakeMinioClient) GetObject(_ context.Context, _, objectName string, _ minio.GetObjectOptions) (io.ReadCloser, error) {
+	data, ok := f.objects[objectName]
+	if !ok {
+		return nil, errors.New("fakeMinioClient: no such key: " + objectName)
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeMinioClient) ListObjects(ctx context.Context, _ string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	ch := make(chan minio.ObjectInfo)
+
+	go func() {
+		defer close(ch)
+
+		seenPrefixes := map[string]bool{}
+		for key, data := range f.objects {
+			if !strings.HasPrefix(key, opts.Prefix) {
+				continue
+			}
+
+			rest := strings.TrimPrefix(key, opts.Prefix)
+			if rest == "" {
+				continue
+			}
+
+			info := minio.ObjectInfo{Key: key, Size: int64(len(data))}
+			if !opts.Recursive {
+				if idx := strings.Index(rest, "/"); idx >= 0 {
+					dirPrefix := opts.Prefix + rest[:idx+1]
+					if seenPrefixes[dirPrefix] {
+						continue
+					}
+					seenPrefixes[dirPrefix] = true
+					info = minio.ObjectInfo{Key: dirPrefix}
+				}
+			}
+
+			select {
+			case ch <- info:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+func (f *fakeMinioClient) RemoveObject(context.Context, string, string, minio.RemoveObjectOptions) error {
+	return errors.New("fakeMinioClient: RemoveObject not implemented")
+}
+
+func (f *fakeMinioClient) RemoveObjects(context.Context, string, <-chan minio.ObjectInfo, minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError {
+	ch := make(chan minio.RemoveObjectError)
+	close(ch)
+	return ch
+}
+
+func (f *fakeMinioClient) CopyObject(context.Context, minio.CopyDestOptions, minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return minio.UploadInfo{}, errors.New("fakeMinioClient: CopyObject not implemented")
+}
+
+func (f *fakeMinioClient) EnableVersioning(context.Context, string) error {
+	return errors.New("fakeMinioClient: EnableVersioning not implemented")
+}
+
+func (f *fakeMinioClient) FGetObject(context.Context, string, string, string, minio.GetObjectOptions) error {
+	return errors.New("fakeMinioClient: FGetObject not implemented")
+}
+
+func (f *fakeMinioClient) PutObject(context.Context, string, string, io.Reader, int64, minio.PutObjectOptions) (minio.UploadInfo, error) {
+	return minio.UploadInfo{}, errors.New("fakeMinioClient: PutObject not implemented")
+}
+
+func (f *fakeMinioClient) PresignedGetObject(context.Context, string, string, time.Duration, url.Values) (*url.URL, error) {
+	return nil, errors.New("fakeMinioClient: PresignedGetObject not implemented")
+}
+
+func (f *fakeMinioClient) PresignedPutObject(context.Context, string, string, time.Duration) (*url.URL, error) {
+	return nil, errors.New("fakeMinioClient: PresignedPutObject not implemented")
+}
+
+func (f *fakeMinioClient) GetBucketLifecycle(context.Context, string) (*lifecycle.Configuration, error) {
+	return nil, errors.New("fakeMinioClient: GetBucketLifecycle not implemented")
+}
+
+func (f *fakeMinioClient) SetBucketLifecycle(context.Context, string, *lifecycle.Configuration) error {
+	return errors.New("fakeMinioClient: SetBucketLifecycle not implemented")
+}
@@ -0,0 +1,244 @@
+package easyminio
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// FS exposes the bucket (rooted at prefix) as a read-only fs.FS. The
+// returned value also implements fs.ReadDirFS, fs.StatFS and fs.SubFS,
+// so it plays with io/fs consumers like http.FS, text/template.ParseFS
+// and testing/fstest without touching the rest of the API.
+func (s *S3Service) FS(prefix string) fs.FS {
+	return &s3FS{s: s, prefix: strings.Trim(prefix, "/")}
+}
+
+type s3FS struct {
+	s      *S3Service
+	prefix string
+}
+
+func (f *s3FS) key(name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	if name == "." {
+		return f.prefix, nil
+	}
+
+	if f.prefix == "" {
+		return name, nil
+	}
+
+	return f.prefix + "/" + name, nil
+}
+
+// Open implements fs.FS.
+func (f *s3FS) Open(name string) (fs.File, error) {
+	key, err := f.key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" || strings.HasSuffix(key, "/") {
+		entries, err := f.readDir(key)
+		if err != nil {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+		}
+		return &s3Dir{name: path.Base(name), entries: entries}, nil
+	}
+
+	info, err := f.s.s3Client.StatObject(context.Background(), f.s.bucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		// not a file - it might be a "directory" prefix with no trailing slash
+		entries, dirErr := f.readDir(key + "/")
+		if dirErr == nil && len(entries) > 0 {
+			return &s3Dir{name: path.Base(name), entries: entries}, nil
+		}
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+
+	obj, err := f.s.s3Client.GetObject(context.Background(), f.s.bucketName, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	return &s3File{name: path.Base(name), obj: obj, info: fileInfoFromObject(path.Base(name), info)}, nil
+}
+
+// Stat implements fs.StatFS.
+func (f *s3FS) Stat(name string) (fs.FileInfo, error) {
+	key, err := f.key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == "" || strings.HasSuffix(key, "/") {
+		return dirInfo(path.Base(name)), nil
+	}
+
+	info, err := f.s.s3Client.StatObject(context.Background(), f.s.bucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		entries, dirErr := f.readDir(key + "/")
+		if dirErr == nil && len(entries) > 0 {
+			return dirInfo(path.Base(name)), nil
+		}
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return fileInfoFromObject(path.Base(name), info), nil
+}
+
+// ReadDir implements fs.ReadDirFS.
+func (f *s3FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	key, err := f.key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if key != "" && !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+
+	entries, err := f.readDir(key)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+
+	return entries, nil
+}
+
+// Sub implements fs.SubFS.
+func (f *s3FS) Sub(dir string) (fs.FS, error) {
+	key, err := f.key(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3FS{s: f.s, prefix: key}, nil
+}
+
+// readDir pages through ListObjects with delimiter "/" under key,
+// synthesizing one fs.DirEntry per common prefix (sub-directory) and
+// object (file).
+func (f *s3FS) readDir(key string) ([]fs.DirEntry, error) {
+	entries := []fs.DirEntry{}
+	for obj := range f.s.s3Client.ListObjects(context.Background(), f.s.bucketName, minio.ListObjectsOptions{Prefix: key}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+
+		if obj.Key == key {
+			continue
+		}
+
+		name := strings.TrimPrefix(obj.Key, key)
+		if name == "" {
+			continue
+		}
+
+		if strings.HasSuffix(name, "/") {
+			entries = append(entries, dirInfo(strings.TrimSuffix(name, "/")))
+			continue
+		}
+
+		entries = append(entries, fileInfoFromObject(name, obj))
+	}
+
+	// ListObjects delivers all objects before all common prefixes, so
+	// entries arrive out of name order - fs.ReadDir and fstest.TestFS
+	// both require entries sorted by filename.
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	return entries, nil
+}
+
+// fileInfo adapts an ObjectInfo (or a synthesized directory) to
+// fs.FileInfo and fs.DirEntry.
+type fileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	isDir   bool
+}
+
+func fileInfoFromObject(name string, obj minio.ObjectInfo) fileInfo {
+	return fileInfo{name: name, size: obj.Size, modTime: obj.LastModified}
+}
+
+func dirInfo(name string) fileInfo {
+	return fileInfo{name: name, isDir: true}
+}
+
+func (i fileInfo) Name() string       { return i.name }
+func (i fileInfo) Size() int64        { return i.size }
+func (i fileInfo) ModTime() time.Time { return i.modTime }
+func (i fileInfo) IsDir() bool        { return i.isDir }
+func (i fileInfo) Sys() interface{}   { return nil }
+
+func (i fileInfo) Mode() fs.FileMode {
+	if i.isDir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+// fs.DirEntry
+func (i fileInfo) Type() fs.FileMode          { return i.Mode().Type() }
+func (i fileInfo) Info() (fs.FileInfo, error) { return i, nil }
+
+// s3File is an fs.File backed by a minio object's body.
+type s3File struct {
+	name string
+	obj  io.ReadCloser
+	info fileInfo
+}
+
+func (f *s3File) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *s3File) Read(p []byte) (int, error) { return f.obj.Read(p) }
+func (f *s3File) Close() error               { return f.obj.Close() }
+
+// s3Dir is an fs.ReadDirFile backed by a synthesized directory listing.
+type s3Dir struct {
+	name    string
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *s3Dir) Stat() (fs.FileInfo, error) { return dirInfo(d.name), nil }
+func (d *s3Dir) Close() error               { return nil }
+
+func (d *s3Dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: fs.ErrInvalid}
+}
+
+func (d *s3Dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 {
+		entries := d.entries[d.offset:]
+		d.offset = len(d.entries)
+		return entries, nil
+	}
+
+	if d.offset >= len(d.entries) {
+		return nil, io.EOF
+	}
+
+	end := d.offset + n
+	if end > len(d.entries) {
+		end = len(d.entries)
+	}
+	entries := d.entries[d.offset:end]
+	d.offset = end
+
+	return entries, nil
+}
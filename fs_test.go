@@ -0,0 +1,30 @@
+package easyminio
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestFS(t *testing.T) {
+	s := newFakeS3Service(map[string][]byte{
+		"file.txt":       []byte("hello"),
+		"dir1/a.txt":     []byte("a"),
+		"dir1/b.txt":     []byte("bb"),
+		"dir2/sub/c.txt": []byte("ccc"),
+	})
+
+	if err := fstest.TestFS(s.FS(""), "file.txt", "dir1/a.txt", "dir1/b.txt", "dir2/sub/c.txt"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFSSub(t *testing.T) {
+	s := newFakeS3Service(map[string][]byte{
+		"bucket-root/dir1/a.txt": []byte("a"),
+		"bucket-root/file.txt":   []byte("hello"),
+	})
+
+	if err := fstest.TestFS(s.FS("bucket-root"), "file.txt", "dir1/a.txt"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,297 @@
+package easyminio
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+// LifecycleRule is a single, typed bucket lifecycle rule.
+//
+// It is a simplified view over minio-go's lifecycle.Rule: zero-value
+// fields (empty Expiration, nil Transitions, ...) are omitted when the
+// rule is converted back to a lifecycle.Rule.
+type LifecycleRule struct {
+	// ID uniquely identifies the rule within the bucket's lifecycle
+	// configuration. Upsert/Remove operate on this field.
+	ID string
+	// Prefix restricts the rule to objects whose key starts with it.
+	Prefix string
+	// Tags restricts the rule to objects carrying all of these tags.
+	Tags map[string]string
+	// Enabled toggles the rule; a disabled rule is kept in the
+	// configuration but not applied by S3.
+	Enabled bool
+
+	// ExpirationDays expires objects this many days after creation.
+	// Zero means no day-based expiration.
+	ExpirationDays int
+	// ExpirationDate expires objects at this fixed point in time.
+	// Zero value means no date-based expiration.
+	ExpirationDate time.Time
+
+	// NoncurrentVersionExpirationDays expires noncurrent object
+	// versions this many days after they became noncurrent. Zero
+	// means the rule doesn't touch noncurrent versions.
+	NoncurrentVersionExpirationDays int
+
+	// AbortIncompleteMultipartUploadDays aborts incomplete multipart
+	// uploads this many days after initiation. Zero disables this.
+	AbortIncompleteMultipartUploadDays int
+
+	// Transitions moves objects to another storage class over time.
+	//
+	// minio-go's typed lifecycle.Rule carries a single Transition, so
+	// only Transitions[0] is sent to the server; Upsert returns an
+	// error if more than one is given rather than silently dropping
+	// the rest.
+	Transitions []LifecycleTransition
+}
+
+// LifecycleTransition moves objects matching a rule to StorageClass
+// once they're Days old (or past Date, whichever is set).
+type LifecycleTransition struct {
+	Days         int
+	Date         time.Time
+	StorageClass string
+}
+
+// ListLifecycleRulesContext returns every lifecycle rule currently
+// configured on the bucket, aborting if ctx is cancelled before it
+// completes.
+func (s *S3Service) ListLifecycleRulesContext(ctx context.Context) ([]LifecycleRule, error) {
+	cfg, err := s.getLifecycleConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]LifecycleRule, 0, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		rules = append(rules, ruleFromLifecycle(r))
+	}
+
+	return rules, nil
+}
+
+// ListLifecycleRules returns every lifecycle rule currently configured
+// on the bucket.
+func (s *S3Service) ListLifecycleRules() ([]LifecycleRule, error) {
+	return s.ListLifecycleRulesContext(context.Background())
+}
+
+// GetLifecycleRuleContext returns the rule with the given ID, aborting
+// if ctx is cancelled before it completes.
+func (s *S3Service) GetLifecycleRuleContext(ctx context.Context, id string) (LifecycleRule, error) {
+	rules, err := s.ListLifecycleRulesContext(ctx)
+	if err != nil {
+		return LifecycleRule{}, err
+	}
+
+	for _, rule := range rules {
+		if rule.ID == id {
+			return rule, nil
+		}
+	}
+
+	return LifecycleRule{}, fmt.Errorf("lifecycle rule %q not found", id)
+}
+
+// GetLifecycleRule returns the rule with the given ID.
+func (s *S3Service) GetLifecycleRule(id string) (LifecycleRule, error) {
+	return s.GetLifecycleRuleContext(context.Background(), id)
+}
+
+// UpsertLifecycleRuleContext adds rule to the bucket's lifecycle
+// configuration, replacing any existing rule with the same ID and
+// aborting if ctx is cancelled before it completes. Other rules are
+// left untouched.
+func (s *S3Service) UpsertLifecycleRuleContext(ctx context.Context, rule LifecycleRule) error {
+	if rule.ID == "" {
+		return fmt.Errorf("lifecycle rule must have an ID")
+	}
+	if len(rule.Transitions) > 1 {
+		return fmt.Errorf("lifecycle rule %q: only one transition is supported", rule.ID)
+	}
+
+	cfg, err := s.getLifecycleConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	lcRule := rule.toLifecycle()
+
+	replaced := false
+	for i, r := range cfg.Rules {
+		if r.ID == rule.ID {
+			cfg.Rules[i] = lcRule
+			replaced = true
+			break
+		}
+	}
+
+	if !replaced {
+		cfg.Rules = append(cfg.Rules, lcRule)
+	}
+
+	return s.putLifecycleConfig(ctx, cfg)
+}
+
+// UpsertLifecycleRule adds rule to the bucket's lifecycle configuration,
+// replacing any existing rule with the same ID. Other rules are left
+// untouched.
+func (s *S3Service) UpsertLifecycleRule(rule LifecycleRule) error {
+	return s.UpsertLifecycleRuleContext(context.Background(), rule)
+}
+
+// RemoveLifecycleRuleContext removes the rule with the given ID from
+// the bucket's lifecycle configuration, aborting if ctx is cancelled
+// before it completes. Other rules are left untouched.
+func (s *S3Service) RemoveLifecycleRuleContext(ctx context.Context, id string) error {
+	cfg, err := s.getLifecycleConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	kept := cfg.Rules[:0]
+	for _, r := range cfg.Rules {
+		if r.ID != id {
+			kept = append(kept, r)
+		}
+	}
+	cfg.Rules = kept
+
+	return s.putLifecycleConfig(ctx, cfg)
+}
+
+// RemoveLifecycleRule removes the rule with the given ID from the
+// bucket's lifecycle configuration. Other rules are left untouched.
+func (s *S3Service) RemoveLifecycleRule(id string) error {
+	return s.RemoveLifecycleRuleContext(context.Background(), id)
+}
+
+// getLifecycleConfig fetches the bucket's lifecycle configuration,
+// treating "no lifecycle configured" as an empty one rather than an
+// error.
+func (s *S3Service) getLifecycleConfig(ctx context.Context) (*lifecycle.Configuration, error) {
+	cfg, err := s.s3Client.GetBucketLifecycle(ctx, s.bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return lifecycle.NewConfiguration(), nil
+		}
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (s *S3Service) putLifecycleConfig(ctx context.Context, cfg *lifecycle.Configuration) error {
+	return s.s3Client.SetBucketLifecycle(ctx, s.bucketName, cfg)
+}
+
+func ruleFromLifecycle(r lifecycle.Rule) LifecycleRule {
+	rule := LifecycleRule{
+		ID:      r.ID,
+		Prefix:  r.Prefix,
+		Enabled: r.Status == "Enabled",
+	}
+
+	switch {
+	case !r.RuleFilter.And.IsEmpty():
+		rule.Prefix = r.RuleFilter.And.Prefix
+		if len(r.RuleFilter.And.Tags) > 0 {
+			rule.Tags = make(map[string]string, len(r.RuleFilter.And.Tags))
+			for _, t := range r.RuleFilter.And.Tags {
+				rule.Tags[t.Key] = t.Value
+			}
+		}
+	case !r.RuleFilter.Tag.IsEmpty():
+		rule.Tags = map[string]string{r.RuleFilter.Tag.Key: r.RuleFilter.Tag.Value}
+	case r.RuleFilter.Prefix != "":
+		rule.Prefix = r.RuleFilter.Prefix
+	}
+
+	rule.ExpirationDays = int(r.Expiration.Days)
+	if !r.Expiration.Date.IsZero() {
+		rule.ExpirationDate = r.Expiration.Date.Time
+	}
+
+	rule.NoncurrentVersionExpirationDays = int(r.NoncurrentVersionExpiration.NoncurrentDays)
+	rule.AbortIncompleteMultipartUploadDays = int(r.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+
+	if r.Transition.StorageClass != "" {
+		transition := LifecycleTransition{
+			Days:         int(r.Transition.Days),
+			StorageClass: r.Transition.StorageClass,
+		}
+		if !r.Transition.Date.IsZero() {
+			transition.Date = r.Transition.Date.Time
+		}
+		rule.Transitions = append(rule.Transitions, transition)
+	}
+
+	return rule
+}
+
+func (rule LifecycleRule) toLifecycle() lifecycle.Rule {
+	status := "Disabled"
+	if rule.Enabled {
+		status = "Enabled"
+	}
+
+	r := lifecycle.Rule{
+		ID:     rule.ID,
+		Status: status,
+	}
+
+	switch {
+	case len(rule.Tags) == 0:
+		// No tags: a bare Prefix at the rule's top level is valid XML
+		// and needs no Filter at all.
+		r.Prefix = rule.Prefix
+	case len(rule.Tags) == 1 && rule.Prefix == "":
+		for k, v := range rule.Tags {
+			r.RuleFilter.Tag = lifecycle.Tag{Key: k, Value: v}
+		}
+	default:
+		// A prefix combined with tags, or more than one tag, must be
+		// expressed as Filter/And - S3 rejects a Rule that sets both
+		// a top-level Prefix and a Filter.
+		r.RuleFilter.And.Prefix = rule.Prefix
+		for k, v := range rule.Tags {
+			r.RuleFilter.And.Tags = append(r.RuleFilter.And.Tags, lifecycle.Tag{Key: k, Value: v})
+		}
+		sort.Slice(r.RuleFilter.And.Tags, func(i, j int) bool {
+			return r.RuleFilter.And.Tags[i].Key < r.RuleFilter.And.Tags[j].Key
+		})
+	}
+
+	if rule.ExpirationDays > 0 {
+		r.Expiration.Days = lifecycle.ExpirationDays(rule.ExpirationDays)
+	}
+	if !rule.ExpirationDate.IsZero() {
+		r.Expiration.Date = lifecycle.ExpirationDate{Time: rule.ExpirationDate}
+	}
+
+	if rule.NoncurrentVersionExpirationDays > 0 {
+		r.NoncurrentVersionExpiration.NoncurrentDays = lifecycle.ExpirationDays(rule.NoncurrentVersionExpirationDays)
+	}
+
+	if rule.AbortIncompleteMultipartUploadDays > 0 {
+		r.AbortIncompleteMultipartUpload.DaysAfterInitiation = lifecycle.ExpirationDays(rule.AbortIncompleteMultipartUploadDays)
+	}
+
+	if len(rule.Transitions) > 0 {
+		t := rule.Transitions[0]
+		r.Transition.Days = lifecycle.ExpirationDays(t.Days)
+		r.Transition.StorageClass = t.StorageClass
+		if !t.Date.IsZero() {
+			r.Transition.Date = lifecycle.ExpirationDate{Time: t.Date}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,90 @@
+package easyminio
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLifecycleRuleRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		rule LifecycleRule
+	}{
+		{
+			name: "prefix only",
+			rule: LifecycleRule{ID: "r1", Prefix: "logs/", Enabled: true, ExpirationDays: 30},
+		},
+		{
+			name: "single tag, no prefix",
+			rule: LifecycleRule{ID: "r2", Tags: map[string]string{"env": "prod"}, Enabled: true, ExpirationDays: 7},
+		},
+		{
+			name: "prefix combined with multiple tags",
+			rule: LifecycleRule{
+				ID:             "r3",
+				Prefix:         "archive/",
+				Tags:           map[string]string{"env": "prod", "team": "data"},
+				Enabled:        true,
+				ExpirationDays: 90,
+			},
+		},
+		{
+			name: "expiration date instead of days",
+			rule: LifecycleRule{ID: "r4", Prefix: "tmp/", Enabled: false, ExpirationDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "noncurrent version expiration and abort multipart",
+			rule: LifecycleRule{
+				ID:                                 "r5",
+				Prefix:                             "uploads/",
+				Enabled:                            true,
+				NoncurrentVersionExpirationDays:    14,
+				AbortIncompleteMultipartUploadDays: 1,
+			},
+		},
+		{
+			name: "single transition",
+			rule: LifecycleRule{
+				ID:      "r6",
+				Prefix:  "cold/",
+				Enabled: true,
+				Transitions: []LifecycleTransition{
+					{Days: 30, StorageClass: "GLACIER"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ruleFromLifecycle(tc.rule.toLifecycle())
+			if !reflect.DeepEqual(got, tc.rule) {
+				t.Errorf("round trip mismatch:\n got:  %+v\nwant: %+v", got, tc.rule)
+			}
+		})
+	}
+}
+
+func TestUpsertLifecycleRuleRejectsMultipleTransitions(t *testing.T) {
+	s := newFakeS3Service(nil)
+
+	err := s.UpsertLifecycleRule(LifecycleRule{
+		ID: "multi",
+		Transitions: []LifecycleTransition{
+			{Days: 30, StorageClass: "GLACIER"},
+			{Days: 60, StorageClass: "DEEP_ARCHIVE"},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected an error for a rule with more than one transition, got nil")
+	}
+}
+
+func TestUpsertLifecycleRuleRequiresID(t *testing.T) {
+	s := newFakeS3Service(nil)
+
+	if err := s.UpsertLifecycleRule(LifecycleRule{Prefix: "logs/"}); err == nil {
+		t.Fatal("expected an error for a rule without an ID, got nil")
+	}
+}
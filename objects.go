@@ -0,0 +1,218 @@
+package easyminio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// ObjectResult is one entry yielded by ListObjects: either an Info or,
+// if listing failed partway through, an Err.
+type ObjectResult struct {
+	Info ObjectInfo
+	Err  error
+}
+
+// CopyOptions configures CopyObject.
+type CopyOptions struct {
+	// UserMetadata replaces the destination object's user metadata
+	// when non-empty. Leave nil or empty to copy the source's
+	// metadata unchanged.
+	UserMetadata map[string]string
+}
+
+// StatObjectContext returns metadata for the object at path, aborting
+// if ctx is cancelled before it completes.
+func (s *S3Service) StatObjectContext(ctx context.Context, path string) (ObjectInfo, error) {
+	info, err := s.s3Client.StatObject(ctx, s.bucketName, path, minio.StatObjectOptions{})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	return objectInfoFromMinio(info), nil
+}
+
+// StatObject returns metadata for the object at path.
+func (s *S3Service) StatObject(path string) (ObjectInfo, error) {
+	return s.StatObjectContext(context.Background(), path)
+}
+
+// ListObjectsContext lists objects under prefix, recursing into
+// sub-"directories" when recursive is true, and streams them back on
+// the returned channel as the underlying listing pages in. Cancel ctx
+// to stop early - the producer goroutine and the underlying listing
+// both select on ctx.Done(), so a caller that abandons the channel
+// without cancelling ctx leaks both.
+func (s *S3Service) ListObjectsContext(ctx context.Context, prefix string, recursive bool) <-chan ObjectResult {
+	objectCh := s.listObjectsContext(ctx, prefix, recursive)
+
+	resultCh := make(chan ObjectResult)
+	go func() {
+		defer close(resultCh)
+
+		for obj := range objectCh {
+			result := ObjectResult{Info: objectInfoFromMinio(obj)}
+			if obj.Err != nil {
+				result = ObjectResult{Err: obj.Err}
+			}
+
+			select {
+			case resultCh <- result:
+			case <-ctx.Done():
+				return
+			}
+
+			if obj.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return resultCh
+}
+
+// ListObjects lists objects under prefix, recursing into
+// sub-"directories" when recursive is true, and streams them back on
+// the returned channel as the underlying listing pages in.
+func (s *S3Service) ListObjects(prefix string, recursive bool) <-chan ObjectResult {
+	return s.ListObjectsContext(context.Background(), prefix, recursive)
+}
+
+// RemoveObjectContext deletes the object at path, aborting if ctx is
+// cancelled before it completes.
+func (s *S3Service) RemoveObjectContext(ctx context.Context, path string) error {
+	return s.s3Client.RemoveObject(ctx, s.bucketName, path, minio.RemoveObjectOptions{})
+}
+
+// RemoveObject deletes the object at path.
+func (s *S3Service) RemoveObject(path string) error {
+	return s.RemoveObjectContext(context.Background(), path)
+}
+
+// RemoveObjectsContext bulk-deletes every object in paths, aborting if
+// ctx is cancelled before it completes, and returns the errors (if
+// any) minio reported for individual keys.
+func (s *S3Service) RemoveObjectsContext(ctx context.Context, paths []string) error {
+	objectsCh := make(chan minio.ObjectInfo, len(paths))
+	for _, p := range paths {
+		objectsCh <- minio.ObjectInfo{Key: p}
+	}
+	close(objectsCh)
+
+	errs := []error{}
+	for removeErr := range s.s3Client.RemoveObjects(ctx, s.bucketName, objectsCh, minio.RemoveObjectsOptions{}) {
+		if removeErr.Err != nil {
+			errs = append(errs, removeErr.Err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove objects: %v", errs)
+	}
+
+	return nil
+}
+
+// RemoveObjects bulk-deletes every object in paths, returning the
+// errors (if any) minio reported for individual keys.
+func (s *S3Service) RemoveObjects(paths []string) error {
+	return s.RemoveObjectsContext(context.Background(), paths)
+}
+
+// CopyObjectContext performs a server-side copy from src to dst
+// within the bucket, aborting if ctx is cancelled before it completes.
+func (s *S3Service) CopyObjectContext(ctx context.Context, src, dst string, opts CopyOptions) error {
+	srcOpts := minio.CopySrcOptions{Bucket: s.bucketName, Object: src}
+
+	dstOpts := minio.CopyDestOptions{
+		Bucket:          s.bucketName,
+		Object:          dst,
+		UserMetadata:    opts.UserMetadata,
+		ReplaceMetadata: len(opts.UserMetadata) > 0,
+	}
+
+	_, err := s.s3Client.CopyObject(ctx, dstOpts, srcOpts)
+	return err
+}
+
+// CopyObject performs a server-side copy from src to dst within the
+// bucket, backed by minio-go's CopyObject.
+func (s *S3Service) CopyObject(src, dst string, opts CopyOptions) error {
+	return s.CopyObjectContext(context.Background(), src, dst, opts)
+}
+
+// EnableVersioningContext turns on bucket versioning, aborting if ctx
+// is cancelled before it completes.
+func (s *S3Service) EnableVersioningContext(ctx context.Context) error {
+	return s.s3Client.EnableVersioning(ctx, s.bucketName)
+}
+
+// EnableVersioning turns on bucket versioning, so every write creates
+// a new, independently addressable version instead of overwriting the
+// previous one.
+func (s *S3Service) EnableVersioning() error {
+	return s.EnableVersioningContext(context.Background())
+}
+
+// GetObjectVersionContext returns metadata for a specific version of
+// the object at path, aborting if ctx is cancelled before it
+// completes.
+func (s *S3Service) GetObjectVersionContext(ctx context.Context, path, versionID string) (ObjectInfo, error) {
+	info, err := s.s3Client.StatObject(ctx, s.bucketName, path, minio.StatObjectOptions{VersionID: versionID})
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	return objectInfoFromMinio(info), nil
+}
+
+// GetObjectVersion returns metadata for a specific version of the
+// object at path.
+func (s *S3Service) GetObjectVersion(path, versionID string) (ObjectInfo, error) {
+	return s.GetObjectVersionContext(context.Background(), path, versionID)
+}
+
+// ListObjectVersionsContext lists every version of every object under
+// prefix, recursing into sub-"directories", streaming them back on
+// the returned channel as the underlying listing pages in. Cancel ctx
+// to stop early - see ListObjectsContext for why that's required to
+// avoid leaking the producer goroutine.
+func (s *S3Service) ListObjectVersionsContext(ctx context.Context, prefix string) <-chan ObjectResult {
+	objectCh := s.s3Client.ListObjects(ctx, s.bucketName, minio.ListObjectsOptions{
+		Prefix:       prefix,
+		Recursive:    true,
+		WithVersions: true,
+	})
+
+	resultCh := make(chan ObjectResult)
+	go func() {
+		defer close(resultCh)
+
+		for obj := range objectCh {
+			result := ObjectResult{Info: objectInfoFromMinio(obj)}
+			if obj.Err != nil {
+				result = ObjectResult{Err: obj.Err}
+			}
+
+			select {
+			case resultCh <- result:
+			case <-ctx.Done():
+				return
+			}
+
+			if obj.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return resultCh
+}
+
+// ListObjectVersions lists every version of every object under
+// prefix, recursing into sub-"directories", streaming them back on
+// the returned channel as the underlying listing pages in.
+func (s *S3Service) ListObjectVersions(prefix string) <-chan ObjectResult {
+	return s.ListObjectVersionsContext(context.Background(), prefix)
+}
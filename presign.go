@@ -0,0 +1,74 @@
+package easyminio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// PresignGetOptions configures GetFileURL / GetFileURLContext.
+type PresignGetOptions struct {
+	// Disposition is the response-content-disposition value, e.g.
+	// "inline" or "attachment". Defaults to "inline".
+	Disposition string
+	// Filename, if set, is appended to Disposition as
+	// `; filename="<Filename>"` so browsers save downloads under
+	// that name.
+	Filename string
+	// ContentType overrides the response-content-type the object is
+	// served with.
+	ContentType string
+	// ExtraQuery is merged into the presigned query string as-is, for
+	// response headers this package doesn't special-case.
+	ExtraQuery url.Values
+}
+
+func (o PresignGetOptions) queryValues() url.Values {
+	values := make(url.Values, len(o.ExtraQuery)+2)
+
+	disposition := o.Disposition
+	if disposition == "" {
+		disposition = "inline"
+	}
+	if o.Filename != "" {
+		disposition = fmt.Sprintf(`%s; filename=%q`, disposition, o.Filename)
+	}
+	values.Set("response-content-disposition", disposition)
+
+	if o.ContentType != "" {
+		values.Set("response-content-type", o.ContentType)
+	}
+
+	for k, vs := range o.ExtraQuery {
+		for _, v := range vs {
+			values.Add(k, v)
+		}
+	}
+
+	return values
+}
+
+// PresignPutOptions configures GetUploadURL. It's currently empty:
+// minio-go's PresignedPutObject takes no reqParams (unlike its GET
+// counterpart), so there's nothing to pass through yet - it's kept as
+// a struct so we can grow it without another signature change.
+type PresignPutOptions struct{}
+
+// GetUploadURL generates a presigned URL that lets the holder PUT the
+// file at path without AWS credentials, expiring after the specified
+// duration.
+func (s *S3Service) GetUploadURL(path string, expiration time.Duration, opts PresignPutOptions) (*url.URL, error) {
+	return s.GetUploadURLContext(context.Background(), path, expiration, opts)
+}
+
+// GetUploadURLContext is GetUploadURL with an early exit if ctx is
+// already done; presigning never talks to the network, so ctx is
+// otherwise only forwarded for minio-go's bookkeeping.
+func (s *S3Service) GetUploadURLContext(ctx context.Context, path string, expiration time.Duration, opts PresignPutOptions) (*url.URL, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.s3Client.PresignedPutObject(ctx, s.bucketName, path, expiration)
+}
@@ -0,0 +1,43 @@
+package easyminio
+
+import (
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// ObjectInfo describes an object stored in the bucket. It mirrors the
+// subset of minio.ObjectInfo that callers of this package need, so
+// they don't have to import minio-go themselves.
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ETag         string
+	ContentType  string
+	LastModified time.Time
+	UserMetadata map[string]string
+	StorageClass string
+	VersionID    string
+	// IsLatest is true when this is the current version of the
+	// object. Always true for objects read from an unversioned
+	// bucket.
+	IsLatest bool
+	// IsDeleteMarker is true when this entry represents a delete
+	// marker rather than an actual object version.
+	IsDeleteMarker bool
+}
+
+func objectInfoFromMinio(obj minio.ObjectInfo) ObjectInfo {
+	return ObjectInfo{
+		Key:            obj.Key,
+		Size:           obj.Size,
+		ETag:           obj.ETag,
+		ContentType:    obj.ContentType,
+		LastModified:   obj.LastModified,
+		UserMetadata:   obj.UserMetadata,
+		StorageClass:   obj.StorageClass,
+		VersionID:      obj.VersionID,
+		IsLatest:       obj.IsLatest,
+		IsDeleteMarker: obj.IsDeleteMarker,
+	}
+}
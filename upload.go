@@ -0,0 +1,161 @@
+package easyminio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// defaultUploadPoolSize bounds the number of concurrent file uploads
+// UploadDirectory will perform when opts.Concurrency is left at zero.
+var defaultUploadPoolSize = runtime.NumCPU()
+
+// UploadOptions configures UploadFile and UploadDirectory.
+type UploadOptions struct {
+	// ContentType is sniffed from the first 512 bytes via
+	// http.DetectContentType when left empty.
+	ContentType          string
+	ContentEncoding      string
+	CacheControl         string
+	UserMetadata         map[string]string
+	StorageClass         string
+	ServerSideEncryption encrypt.ServerSide
+
+	// PartSize overrides minio-go's default multipart chunk size.
+	// Zero keeps the library default.
+	PartSize uint64
+	// Concurrency sets the number of multipart upload threads per
+	// file; UploadDirectory also uses it as the number of files
+	// uploaded at once. Zero keeps minio-go's default thread count
+	// and, for UploadDirectory, uploads runtime.NumCPU() files at once.
+	Concurrency uint
+
+	// Progress, if set, is written to as bytes are read from the
+	// source reader - wire it up to a progress bar or counter.
+	Progress io.Writer
+}
+
+func (o UploadOptions) putObjectOptions() minio.PutObjectOptions {
+	opts := minio.PutObjectOptions{
+		ContentType:          o.ContentType,
+		ContentEncoding:      o.ContentEncoding,
+		CacheControl:         o.CacheControl,
+		UserMetadata:         o.UserMetadata,
+		StorageClass:         o.StorageClass,
+		ServerSideEncryption: o.ServerSideEncryption,
+		PartSize:             o.PartSize,
+	}
+
+	if o.Concurrency > 0 {
+		opts.NumThreads = o.Concurrency
+	}
+
+	return opts
+}
+
+func (o UploadOptions) concurrency() int {
+	if o.Concurrency > 0 {
+		return int(o.Concurrency)
+	}
+	return defaultUploadPoolSize
+}
+
+// UploadFile uploads the contents of r to path, detecting the content
+// type from the stream when opts.ContentType is empty and applying
+// the rest of opts (encoding, metadata, storage class, multipart
+// tuning, progress reporting) to the upload.
+func (s *S3Service) UploadFile(path string, r io.Reader, opts UploadOptions) (ObjectInfo, error) {
+	return s.UploadFileContext(context.Background(), path, r, opts)
+}
+
+// detectContentType peeks up to 512 bytes of r to run
+// http.DetectContentType, returning a reader that still yields the
+// full, unconsumed stream.
+func detectContentType(r io.Reader) (string, io.Reader, error) {
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	buf = buf[:n]
+
+	return http.DetectContentType(buf), io.MultiReader(bytes.NewReader(buf), r), nil
+}
+
+// UploadDirectory concurrently uploads every file under localPath to
+// the bucket under remotePrefix, preserving the directory structure.
+// Uploads run through a bounded worker pool sized by opts.Concurrency
+// (default runtime.NumCPU()) so large trees don't spawn one goroutine
+// per file.
+func (s *S3Service) UploadDirectory(localPath, remotePrefix string, opts UploadOptions) error {
+	paths := []string{}
+	err := filepath.Walk(localPath, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		paths = append(paths, p)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to walk %s: %w", localPath, err)
+	}
+
+	sem := make(chan struct{}, opts.concurrency())
+	wg := sync.WaitGroup{}
+	errCh := make(chan error, len(paths))
+
+	for _, p := range paths {
+		rel, err := filepath.Rel(localPath, p)
+		if err != nil {
+			return err
+		}
+		remotePath := remotePrefix + "/" + filepath.ToSlash(rel)
+		remotePath = strings.TrimPrefix(remotePath, "/")
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(localFile, remoteFile string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			f, err := os.Open(localFile)
+			if err != nil {
+				errCh <- err
+				return
+			}
+			defer f.Close()
+
+			if _, err := s.UploadFile(remoteFile, f, opts); err != nil {
+				errCh <- fmt.Errorf("failed to upload %s: %w", localFile, err)
+			}
+		}(p, remotePath)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	errs := []error{}
+	for err := range errCh {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to upload directory %s: %v", localPath, errs)
+	}
+
+	return nil
+}